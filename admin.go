@@ -0,0 +1,144 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+func decodeJSONBody(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// adminOrganizationsHandler serves the curator CRUD surface at
+// POST/PUT/DELETE /api/v1/admin/organizations[/{id}]. It is wrapped in
+// requireRole(roleAdmin, ...) by main, so every request here already
+// carries a valid admin token.
+func (s *server) adminOrganizationsHandler(w http.ResponseWriter, r *http.Request) {
+	idStr := strings.Trim(strings.TrimPrefix(r.URL.Path, "/api/v1/admin/organizations"), "/")
+
+	switch r.Method {
+	case http.MethodPost:
+		s.createOrgHandler(w, r)
+	case http.MethodPut:
+		s.updateOrgHandler(w, r, idStr)
+	case http.MethodDelete:
+		s.deleteOrgHandler(w, r, idStr)
+	default:
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *server) createOrgHandler(w http.ResponseWriter, r *http.Request) {
+	var o Org
+	if err := decodeJSONBody(r, &o); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if o.Name == "" || o.Year == 0 {
+		writeJSONError(w, http.StatusBadRequest, "name and year are required")
+		return
+	}
+
+	created, err := s.store.CreateOrg(r.Context(), o)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *server) updateOrgHandler(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "id must be an integer")
+		return
+	}
+
+	var patch orgPatch
+	if err := decodeJSONBody(r, &patch); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	existing, err := s.store.GetOrg(r.Context(), id)
+	if err == sql.ErrNoRows {
+		writeJSONError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	o := patch.applyTo(existing)
+	if o.Name == "" || o.Year == 0 {
+		writeJSONError(w, http.StatusBadRequest, "name and year are required")
+		return
+	}
+
+	if err := s.store.UpdateOrg(r.Context(), id, o); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	o.ID = id
+	writeJSON(w, http.StatusOK, o)
+}
+
+// orgPatch is the PUT body for updateOrgHandler. Pointer fields distinguish
+// "omitted" (nil) from "explicitly set to the zero value" (e.g. {"url": ""}
+// to clear it), which a plain Org can't express.
+type orgPatch struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	URL         *string `json:"url"`
+	Year        *int    `json:"year"`
+}
+
+// applyTo overlays the provided fields of p onto existing, leaving any
+// omitted field unchanged.
+func (p orgPatch) applyTo(existing Org) Org {
+	merged := existing
+	if p.Name != nil {
+		merged.Name = *p.Name
+	}
+	if p.Description != nil {
+		merged.Description = *p.Description
+	}
+	if p.URL != nil {
+		merged.URL = *p.URL
+	}
+	if p.Year != nil {
+		merged.Year = *p.Year
+	}
+	return merged
+}
+
+func (s *server) deleteOrgHandler(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "id must be an integer")
+		return
+	}
+
+	if _, err := s.store.GetOrg(r.Context(), id); err == sql.ErrNoRows {
+		writeJSONError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+
+	if err := s.store.DeleteOrg(r.Context(), id); err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// envelope is the common response shape for the list endpoint.
+type envelope struct {
+	Data       interface{} `json:"data"`
+	Pagination pagination  `json:"pagination"`
+}
+
+type pagination struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeJSONError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// organizationsHandler serves GET /api/v1/organizations and
+// GET /api/v1/organizations/{id}.
+func (s *server) organizationsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	id := strings.TrimPrefix(r.URL.Path, "/api/v1/organizations")
+	id = strings.Trim(id, "/")
+	if id != "" {
+		s.getOrganizationHandler(w, r, id)
+		return
+	}
+	s.listOrganizationsHandler(w, r)
+}
+
+func (s *server) listOrganizationsHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	filter := OrgFilter{
+		Name: q.Get("name"),
+		Sort: q.Get("sort"),
+	}
+	if year := q.Get("year"); year != "" {
+		y, err := strconv.Atoi(year)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "year must be an integer")
+			return
+		}
+		filter.Year = y
+	}
+	if limit := q.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "limit must be an integer")
+			return
+		}
+		filter.Limit = l
+	}
+	if offset := q.Get("offset"); offset != "" {
+		o, err := strconv.Atoi(offset)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "offset must be an integer")
+			return
+		}
+		filter.Offset = o
+	}
+
+	orgs, total, err := s.store.ListOrgs(r.Context(), filter)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	limit, offset := clampFilter(filter)
+
+	writeJSON(w, http.StatusOK, envelope{
+		Data: orgs,
+		Pagination: pagination{
+			Total:  total,
+			Limit:  limit,
+			Offset: offset,
+		},
+	})
+}
+
+func (s *server) getOrganizationHandler(w http.ResponseWriter, r *http.Request, idStr string) {
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "id must be an integer")
+		return
+	}
+
+	org, err := s.store.GetOrg(r.Context(), id)
+	if err == sql.ErrNoRows {
+		writeJSONError(w, http.StatusNotFound, "organization not found")
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, org)
+}
+
+// yearsHandler serves GET /api/v1/years.
+func (s *server) yearsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	years, err := s.store.Years(r.Context())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "database error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": years})
+}
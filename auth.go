@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// role is the access level carried in a token's claims.
+type role string
+
+const (
+	roleAdmin  role = "admin"
+	roleViewer role = "viewer"
+
+	tokenIssuer = "gsoc-org"
+	tokenTTL    = 12 * time.Hour
+)
+
+// claims is the payload of tokens issued by loginHandler.
+type claims struct {
+	Role role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+type contextKey string
+
+const claimsContextKey contextKey = "claims"
+
+func signingSecret() []byte {
+	return []byte(os.Getenv("JWT_SECRET"))
+}
+
+// issueToken signs a new HS256 token for r, valid for tokenTTL.
+func issueToken(r role) (string, error) {
+	now := time.Now()
+	c := claims{
+		Role: r,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(tokenTTL)),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, c)
+	return token.SignedString(signingSecret())
+}
+
+// parseToken validates signature, exp/nbf/iss, and returns the claims.
+func parseToken(raw string) (*claims, error) {
+	c := &claims{}
+	token, err := jwt.ParseWithClaims(raw, c, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return signingSecret(), nil
+	}, jwt.WithIssuer(tokenIssuer), jwt.WithValidMethods([]string{"HS256"}))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return c, nil
+}
+
+// requireRole wraps next so it only runs for requests bearing a valid JWT
+// whose role is r. The parsed claims are stashed in the request context
+// under claimsContextKey for handlers that need them.
+func requireRole(r role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		header := req.Header.Get("Authorization")
+		tokenStr, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || tokenStr == "" {
+			writeJSONError(w, http.StatusUnauthorized, "missing bearer token")
+			return
+		}
+
+		c, err := parseToken(tokenStr)
+		if err != nil {
+			writeJSONError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		if c.Role != r {
+			writeJSONError(w, http.StatusForbidden, "insufficient role")
+			return
+		}
+
+		ctx := context.WithValue(req.Context(), claimsContextKey, c)
+		next(w, req.WithContext(ctx))
+	}
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// loginHandler exchanges the bootstrap admin credentials (from env) for an
+// admin-role bearer token. There is no user store: this is a single
+// curator account meant to unblock manual corrections, not a full IdP.
+func loginHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	var req loginRequest
+	if err := decodeJSONBody(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.Username != os.Getenv("ADMIN_USERNAME") || req.Password != os.Getenv("ADMIN_PASSWORD") {
+		writeJSONError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	token, err := issueToken(roleAdmin)
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "could not issue token")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"token": token})
+}
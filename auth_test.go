@@ -0,0 +1,86 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signTestToken(t *testing.T, secret string, r role, iat time.Time, exp time.Time) string {
+	t.Helper()
+	c := claims{
+		Role: r,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    tokenIssuer,
+			IssuedAt:  jwt.NewNumericDate(iat),
+			NotBefore: jwt.NewNumericDate(iat),
+			ExpiresAt: jwt.NewNumericDate(exp),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("signing test token: %v", err)
+	}
+	return token
+}
+
+func TestRequireRole(t *testing.T) {
+	os.Setenv("JWT_SECRET", "test-secret")
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{
+			name:       "happy path",
+			token:      signTestToken(t, "test-secret", roleAdmin, now, now.Add(time.Hour)),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "expired token",
+			token:      signTestToken(t, "test-secret", roleAdmin, now.Add(-2*time.Hour), now.Add(-time.Hour)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "wrong signature",
+			token:      signTestToken(t, "wrong-secret", roleAdmin, now, now.Add(time.Hour)),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "missing role",
+			token:      signTestToken(t, "test-secret", roleViewer, now, now.Add(time.Hour)),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "missing token",
+			token:      "",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := requireRole(roleAdmin, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/organizations", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+
+			handler(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -1,17 +1,13 @@
 package main
 
 import (
-	"database/sql"
-	"encoding/json"
+	"context"
 	"fmt"
 	"html/template"
-	"io"
 	"log"
 	"net/http"
 	"os"
 	"time"
-
-	_ "github.com/go-sql-driver/mysql"
 )
 
 // --- Configuration ---
@@ -23,6 +19,7 @@ const (
 
 // Org represents a single GSoC organization from the API
 type Org struct {
+	ID          int    `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
 	URL         string `json:"url"`
@@ -31,96 +28,124 @@ type Org struct {
 
 // PageData is passed to the HTML template
 type PageData struct {
-	Orgs      []Org
-	SyncTime  string
-	ErrorMsg  string
+	Orgs     []Org
+	SyncTime string
+	ErrorMsg string
 }
 
-// --- Global Database Handle ---
-var db *sql.DB
+// server holds the shared dependencies for the HTTP handlers. Using a
+// struct instead of package-level globals lets tests wire up a sqliteStore
+// without a container.
+type server struct {
+	store  Store
+	syncer *Syncer
+}
 
-func main() {
-	// 1. Database Connection with Retry Logic
+// dsnFromEnv builds the DSN for driver from the usual DB_* env vars.
+func dsnFromEnv(driver string) string {
 	dbUser := os.Getenv("DB_USER")
 	dbPass := os.Getenv("DB_PASSWORD")
 	dbHost := os.Getenv("DB_HOST")
 	dbName := os.Getenv("DB_NAME")
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?parseTime=true", dbUser, dbPass, dbHost, dbName)
-	
-	var err error
-	// Retry connection for 30 seconds (waiting for MySQL container to start)
+	switch driver {
+	case "postgres":
+		return fmt.Sprintf("postgres://%s:%s@%s:5432/%s?sslmode=disable", dbUser, dbPass, dbHost, dbName)
+	case "sqlite":
+		if dbName == "" {
+			dbName = "gsoc.db"
+		}
+		return dbName
+	default: // mysql
+		return fmt.Sprintf("%s:%s@tcp(%s:3306)/%s?parseTime=true", dbUser, dbPass, dbHost, dbName)
+	}
+}
+
+// requireEnv fatals with a clear message if any of the given env vars are
+// unset, so a misconfigured deploy refuses to start instead of, e.g.,
+// issuing admin tokens for empty credentials (see loginHandler).
+func requireEnv(names ...string) {
+	for _, name := range names {
+		if os.Getenv(name) == "" {
+			log.Fatalf("%s must be set", name)
+		}
+	}
+}
+
+func main() {
+	requireEnv("JWT_SECRET", "ADMIN_USERNAME", "ADMIN_PASSWORD")
+
+	driver := os.Getenv("DB_DRIVER")
+	store, err := NewStore(driver, dsnFromEnv(driver))
+	if err != nil {
+		log.Fatal("Could not configure store: ", err)
+	}
+
+	// Retry connection for 30 seconds (waiting for the DB container to start)
 	for i := 0; i < 10; i++ {
-		db, err = sql.Open("mysql", dsn)
-		if err == nil {
-			err = db.Ping()
-			if err == nil {
-				break
-			}
+		if err = store.Ping(context.Background()); err == nil {
+			break
 		}
 		log.Println("Waiting for database...", err)
 		time.Sleep(3 * time.Second)
 	}
-
 	if err != nil {
 		log.Fatal("Could not connect to database after retries: ", err)
 	}
-	defer db.Close()
+	defer store.Close()
+	state.dbConnected.Store(true)
+
+	s := &server{store: store, syncer: NewSyncer(store)}
 
 	// 2. Initialize Schema
-	initDB()
+	s.initDB()
+
+	// Run the background sync loop for the lifetime of the process.
+	go s.syncer.Run(context.Background())
 
 	// 3. Setup Routes
-	http.HandleFunc("/", homeHandler)
-	http.HandleFunc("/sync", syncHandler)
-	http.HandleFunc("/health", healthHandler) // For Smoke Tests
+	http.HandleFunc("/", withObservability("/", s.homeHandler))
+	http.HandleFunc("/sync", withObservability("/sync", s.syncHandler))
+	http.HandleFunc("/livez", withObservability("/livez", livezHandler))
+	http.HandleFunc("/readyz", withObservability("/readyz", s.readyzHandler))
+	http.Handle("/metrics", metricsHandler)
+	http.HandleFunc("/api/v1/organizations", withObservability("/api/v1/organizations", s.organizationsHandler))
+	http.HandleFunc("/api/v1/organizations/", withObservability("/api/v1/organizations/", s.organizationsHandler))
+	http.HandleFunc("/api/v1/years", withObservability("/api/v1/years", s.yearsHandler))
+	http.HandleFunc("/api/v1/sync/status", withObservability("/api/v1/sync/status", s.syncStatusHandler))
+	http.HandleFunc("/api/v1/openapi.json", withObservability("/api/v1/openapi.json", openapiHandler))
+	http.HandleFunc("/docs", withObservability("/docs", docsHandler))
+	http.HandleFunc("/api/v1/admin/login", withObservability("/api/v1/admin/login", loginHandler))
+	http.HandleFunc("/api/v1/admin/organizations", withObservability("/api/v1/admin/organizations", requireRole(roleAdmin, s.adminOrganizationsHandler)))
+	http.HandleFunc("/api/v1/admin/organizations/", withObservability("/api/v1/admin/organizations/", requireRole(roleAdmin, s.adminOrganizationsHandler)))
 
 	port := ":8080"
 	log.Printf("Server starting on %s", port)
 	log.Fatal(http.ListenAndServe(port, nil))
 }
 
-// initDB creates the table if it doesn't exist
-func initDB() {
-	query := `
-	CREATE TABLE IF NOT EXISTS organizations (
-		id INT AUTO_INCREMENT PRIMARY KEY,
-		name VARCHAR(255) NOT NULL,
-		description TEXT,
-		url VARCHAR(255),
-		year INT NOT NULL,
-		UNIQUE KEY unique_org_year (name, year)
-	);`
-	_, err := db.Exec(query)
-	if err != nil {
-		log.Fatal("Failed to create table:", err)
+// initDB applies the store's migrations.
+func (s *server) initDB() {
+	if err := s.store.Init(context.Background()); err != nil {
+		log.Fatal("Failed to initialize schema:", err)
 	}
+	state.schemaInitialized.Store(true)
 }
 
 // homeHandler displays the data
-func homeHandler(w http.ResponseWriter, r *http.Request) {
-	rows, err := db.Query("SELECT name, description, url, year FROM organizations ORDER BY year DESC, name ASC")
+func (s *server) homeHandler(w http.ResponseWriter, r *http.Request) {
+	orgs, _, err := s.store.ListOrgs(r.Context(), OrgFilter{Limit: maxLimit})
 	if err != nil {
 		http.Error(w, "Database error", http.StatusInternalServerError)
 		return
 	}
-	defer rows.Close()
-
-	var orgs []Org
-	for rows.Next() {
-		var o Org
-		if err := rows.Scan(&o.Name, &o.Description, &o.URL, &o.Year); err != nil {
-			continue
-		}
-		orgs = append(orgs, o)
-	}
 
 	tmpl, err := template.ParseFiles("templates/index.html")
 	if err != nil {
 		http.Error(w, "Template error", http.StatusInternalServerError)
 		return
 	}
-	
+
 	data := PageData{
 		Orgs:     orgs,
 		SyncTime: time.Now().Format(time.RFC1123),
@@ -128,78 +153,15 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 	tmpl.Execute(w, data)
 }
 
-// syncHandler fetches data from the external API for the last 4 years
-// syncHandler fetches data from the external API for the last 4 years
-func syncHandler(w http.ResponseWriter, r *http.Request) {
-	// The API currently has robust data up to 2025. 
-	years := []int{2022, 2023, 2024, 2025}
-
-	for _, year := range years {
-		url := fmt.Sprintf("%s%d.json", APIBaseURL, year)
-		log.Printf("Fetching data for year %d from %s", year, url)
-
-		// 1. Fetch
-		resp, err := http.Get(url)
-		if err != nil {
-			log.Printf("Failed to fetch %d: %v", year, err)
-			continue
-		}
-		
-		if resp.StatusCode != 200 {
-			log.Printf("API returned %d for year %d", resp.StatusCode, year)
-			resp.Body.Close()
-			continue
-		}
-
-		body, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-
-		// 2. Parse into a Map (since the JSON root is an Object, not an Array)
-		var apiResponse map[string]interface{}
-		if err := json.Unmarshal(body, &apiResponse); err != nil {
-			log.Printf("JSON Parse error for %d: %v", year, err)
-			continue
-		}
-
-		// 3. Insert into DB
-		stmt, err := db.Prepare("INSERT IGNORE INTO organizations (name, description, url, year) VALUES (?, ?, ?, ?)")
-		if err != nil {
-			log.Printf("Database prepare error: %v", err)
-			continue
-		}
-		
-		// Iterate through the map. The key is the Organization Name.
-		for orgName, orgData := range apiResponse {
-			description := "GSoC Organization"
-			orgURL := ""
-
-			// Safely extract the projects_url if the API provided it
-			if dataMap, ok := orgData.(map[string]interface{}); ok {
-				if pUrl, exists := dataMap["projects_url"].(string); exists {
-					orgURL = pUrl
-				}
-			}
-
-			stmt.Exec(orgName, description, orgURL, year)
-		}
-		stmt.Close()
+// syncHandler enqueues an immediate sync run and returns without waiting
+// for it to finish; poll /api/v1/sync/status for the outcome. The actual
+// fetching happens in the background Syncer (see syncer.go).
+func (s *server) syncHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
 	}
 
-	// Redirect back to home
-	http.Redirect(w, r, "/", http.StatusSeeOther)
+	s.syncer.Trigger()
+	writeJSON(w, http.StatusAccepted, map[string]string{"status": "triggered"})
 }
-
-// healthHandler is for Kubernetes liveness/readiness probes and smoke tests
-func healthHandler(w http.ResponseWriter, r *http.Request) {
-	if db == nil {
-		http.Error(w, "DB not initialized", http.StatusServiceUnavailable)
-		return
-	}
-	err := db.Ping()
-	if err != nil {
-		http.Error(w, "DB connection failed", http.StatusServiceUnavailable)
-		return
-	}
-	w.WriteHeader(http.StatusOK)
-	w.Write([]byte("OK"))
-}
\ No newline at end of file
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests, labeled by path, method, and status code.",
+	}, []string{"path", "method", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency in seconds, labeled by path and method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"path", "method"})
+
+	gsocOrgsTotal = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gsoc_orgs_total",
+		Help: "Number of organizations upserted in the most recent sync of a year.",
+	}, []string{"year"})
+
+	gsocSyncDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gsoc_sync_duration_seconds",
+		Help:    "Duration of a single year's sync, in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"year"})
+
+	gsocSyncFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gsoc_sync_failures_total",
+		Help: "Total sync failures, labeled by year and reason.",
+	}, []string{"year", "reason"})
+
+	gsocDBUp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "gsoc_db_up",
+		Help: "1 if the last database ping succeeded, 0 otherwise.",
+	})
+)
+
+type requestIDKey struct{}
+
+// statusRecorder wraps a ResponseWriter to capture the status code and
+// byte count for logging and metrics, since http.ResponseWriter exposes
+// neither after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// withObservability wraps next so every request is counted, timed, and
+// logged as structured JSON. path is the metric/log label to use, since
+// the raw URL path can have unbounded cardinality (e.g. /organizations/{id}).
+func withObservability(path string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		reqID := r.Header.Get("X-Request-ID")
+		if reqID == "" {
+			reqID = genRequestID()
+		}
+		ctx := context.WithValue(r.Context(), requestIDKey{}, reqID)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next(rec, r.WithContext(ctx))
+
+		duration := time.Since(start)
+		status := strconv.Itoa(rec.status)
+
+		httpRequestsTotal.WithLabelValues(path, r.Method, status).Inc()
+		httpRequestDuration.WithLabelValues(path, r.Method).Observe(duration.Seconds())
+
+		slog.Info("http_request",
+			"request_id", reqID,
+			"method", r.Method,
+			"path", path,
+			"status", rec.status,
+			"bytes", rec.bytes,
+			"latency_ms", duration.Milliseconds(),
+		)
+	}
+}
+
+func genRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+var metricsHandler = promhttp.Handler()
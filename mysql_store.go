@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+)
+
+var mysqlMigrations = []migration{
+	{Version: 1, SQL: `
+		CREATE TABLE IF NOT EXISTS organizations (
+			id INT AUTO_INCREMENT PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			description TEXT,
+			url VARCHAR(255),
+			year INT NOT NULL,
+			UNIQUE KEY unique_org_year (name, year)
+		)`},
+	{Version: 2, SQL: `
+		CREATE TABLE IF NOT EXISTS sync_state (
+			year INT PRIMARY KEY,
+			etag VARCHAR(255),
+			last_modified VARCHAR(255)
+		)`},
+}
+
+const mysqlTrackingTableSQL = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INT PRIMARY KEY
+	)`
+
+// mysqlStore is the original, default Store backend.
+type mysqlStore struct {
+	db *sql.DB
+}
+
+func newMySQLStore(dsn string) (Store, error) {
+	conn, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlStore{db: conn}, nil
+}
+
+func (s *mysqlStore) Init(ctx context.Context) error {
+	return runMigrations(ctx, s.db, mysqlTrackingTableSQL,
+		"INSERT INTO schema_migrations (version) VALUES (?)", mysqlMigrations)
+}
+
+func (s *mysqlStore) ListOrgs(ctx context.Context, filter OrgFilter) ([]Org, int, error) {
+	limit, offset := clampFilter(filter)
+	order, ok := sortColumns[filter.Sort]
+	if !ok {
+		order = "year DESC, name ASC"
+	}
+
+	var where []string
+	var args []interface{}
+	if filter.Year != 0 {
+		where = append(where, "year = ?")
+		args = append(args, filter.Year)
+	}
+	if filter.Name != "" {
+		where = append(where, "name LIKE ?")
+		args = append(args, "%"+filter.Name+"%")
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM organizations %s", whereClause)
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := fmt.Sprintf(
+		"SELECT id, name, description, url, year FROM organizations %s ORDER BY %s LIMIT ? OFFSET ?",
+		whereClause, order,
+	)
+	rows, err := s.db.QueryContext(ctx, listQuery, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var orgs []Org
+	for rows.Next() {
+		var o Org
+		if err := rows.Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year); err != nil {
+			return nil, 0, err
+		}
+		orgs = append(orgs, o)
+	}
+	return orgs, total, rows.Err()
+}
+
+func (s *mysqlStore) GetOrg(ctx context.Context, id int) (Org, error) {
+	var o Org
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, name, description, url, year FROM organizations WHERE id = ?", id,
+	).Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year)
+	return o, err
+}
+
+func (s *mysqlStore) CreateOrg(ctx context.Context, o Org) (Org, error) {
+	res, err := s.db.ExecContext(ctx,
+		"INSERT INTO organizations (name, description, url, year) VALUES (?, ?, ?, ?)",
+		o.Name, o.Description, o.URL, o.Year,
+	)
+	if err != nil {
+		return Org{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Org{}, err
+	}
+	o.ID = int(id)
+	return o, nil
+}
+
+func (s *mysqlStore) UpdateOrg(ctx context.Context, id int, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		"UPDATE organizations SET name = ?, description = ?, url = ?, year = ? WHERE id = ?",
+		o.Name, o.Description, o.URL, o.Year, id,
+	)
+	return err
+}
+
+func (s *mysqlStore) DeleteOrg(ctx context.Context, id int) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM organizations WHERE id = ?", id)
+	return err
+}
+
+func (s *mysqlStore) UpsertOrg(ctx context.Context, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO organizations (name, description, url, year) VALUES (?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE description = VALUES(description), url = VALUES(url)`,
+		o.Name, o.Description, o.URL, o.Year,
+	)
+	return err
+}
+
+func (s *mysqlStore) GetSyncState(ctx context.Context, year int) (etag, lastModified string, err error) {
+	err = s.db.QueryRowContext(ctx,
+		"SELECT etag, last_modified FROM sync_state WHERE year = ?", year,
+	).Scan(&etag, &lastModified)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	return etag, lastModified, err
+}
+
+func (s *mysqlStore) SetSyncState(ctx context.Context, year int, etag, lastModified string) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO sync_state (year, etag, last_modified) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE etag = VALUES(etag), last_modified = VALUES(last_modified)`,
+		year, etag, lastModified,
+	)
+	return err
+}
+
+func (s *mysqlStore) Years(ctx context.Context) ([]int, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT DISTINCT year FROM organizations ORDER BY year DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var years []int
+	for rows.Next() {
+		var y int
+		if err := rows.Scan(&y); err != nil {
+			return nil, err
+		}
+		years = append(years, y)
+	}
+	return years, rows.Err()
+}
+
+func (s *mysqlStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *mysqlStore) Close() error {
+	return s.db.Close()
+}
+
+var sortColumns = map[string]string{
+	"name":  "name ASC",
+	"-name": "name DESC",
+	"year":  "year ASC",
+	"-year": "year DESC",
+}
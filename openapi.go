@@ -0,0 +1,241 @@
+package main
+
+import "net/http"
+
+// openapiSpec is a hand-maintained OpenAPI 3 document for the JSON API.
+// Keep it in sync with api.go when endpoints change.
+const openapiSpec = `{
+  "openapi": "3.0.3",
+  "info": {
+    "title": "gsoc-org API",
+    "version": "1.0.0",
+    "description": "Read-only JSON API over the synced GSoC organizations data."
+  },
+  "paths": {
+    "/api/v1/organizations": {
+      "get": {
+        "summary": "List organizations",
+        "parameters": [
+          {"name": "year", "in": "query", "schema": {"type": "integer"}},
+          {"name": "name", "in": "query", "schema": {"type": "string"}, "description": "substring match"},
+          {"name": "limit", "in": "query", "schema": {"type": "integer", "default": 20, "maximum": 100}},
+          {"name": "offset", "in": "query", "schema": {"type": "integer", "default": 0}},
+          {"name": "sort", "in": "query", "schema": {"type": "string", "enum": ["name", "-name", "year", "-year"]}}
+        ],
+        "responses": {
+          "200": {
+            "description": "A page of organizations",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {
+                    "data": {"type": "array", "items": {"$ref": "#/components/schemas/Organization"}},
+                    "pagination": {"$ref": "#/components/schemas/Pagination"}
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/organizations/{id}": {
+      "get": {
+        "summary": "Get a single organization",
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}}
+        ],
+        "responses": {
+          "200": {
+            "description": "The organization",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Organization"}}}
+          },
+          "404": {"description": "Organization not found"}
+        }
+      }
+    },
+    "/api/v1/years": {
+      "get": {
+        "summary": "List distinct years with synced data",
+        "responses": {
+          "200": {
+            "description": "Distinct years, most recent first",
+            "content": {
+              "application/json": {
+                "schema": {
+                  "type": "object",
+                  "properties": {"data": {"type": "array", "items": {"type": "integer"}}}
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/api/v1/sync/status": {
+      "get": {
+        "summary": "Get the most recent background sync run's outcome, per year",
+        "responses": {
+          "200": {
+            "description": "The last sync run's status",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/SyncStatus"}}}
+          }
+        }
+      }
+    },
+    "/api/v1/admin/login": {
+      "post": {
+        "summary": "Exchange bootstrap admin credentials for a bearer token",
+        "requestBody": {
+          "required": true,
+          "content": {
+            "application/json": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "username": {"type": "string"},
+                  "password": {"type": "string"}
+                }
+              }
+            }
+          }
+        },
+        "responses": {
+          "200": {
+            "description": "A signed admin-role JWT",
+            "content": {
+              "application/json": {
+                "schema": {"type": "object", "properties": {"token": {"type": "string"}}}
+              }
+            }
+          },
+          "401": {"description": "Invalid credentials"}
+        }
+      }
+    },
+    "/api/v1/admin/organizations": {
+      "post": {
+        "summary": "Create an organization",
+        "security": [{"bearerAuth": []}],
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Organization"}}}
+        },
+        "responses": {
+          "201": {
+            "description": "The created organization",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Organization"}}}
+          },
+          "400": {"description": "Missing name or year"}
+        }
+      }
+    },
+    "/api/v1/admin/organizations/{id}": {
+      "put": {
+        "summary": "Update an organization; omitted fields are left unchanged",
+        "security": [{"bearerAuth": []}],
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}}
+        ],
+        "requestBody": {
+          "required": true,
+          "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Organization"}}}
+        },
+        "responses": {
+          "200": {
+            "description": "The updated organization",
+            "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Organization"}}}
+          },
+          "404": {"description": "Organization not found"}
+        }
+      },
+      "delete": {
+        "summary": "Delete an organization",
+        "security": [{"bearerAuth": []}],
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "schema": {"type": "integer"}}
+        ],
+        "responses": {
+          "204": {"description": "Deleted"},
+          "404": {"description": "Organization not found"}
+        }
+      }
+    }
+  },
+  "components": {
+    "securitySchemes": {
+      "bearerAuth": {"type": "http", "scheme": "bearer", "bearerFormat": "JWT"}
+    },
+    "schemas": {
+      "Organization": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "integer"},
+          "name": {"type": "string"},
+          "description": {"type": "string"},
+          "url": {"type": "string"},
+          "year": {"type": "integer"}
+        }
+      },
+      "Pagination": {
+        "type": "object",
+        "properties": {
+          "total": {"type": "integer"},
+          "limit": {"type": "integer"},
+          "offset": {"type": "integer"}
+        }
+      },
+      "SyncResult": {
+        "type": "object",
+        "properties": {
+          "year": {"type": "integer"},
+          "status": {"type": "string", "enum": ["synced", "not_modified", "error"]},
+          "rowCount": {"type": "integer"},
+          "durationNs": {"type": "integer"},
+          "error": {"type": "string"}
+        }
+      },
+      "SyncStatus": {
+        "type": "object",
+        "properties": {
+          "lastRun": {"type": "string", "format": "date-time"},
+          "years": {
+            "type": "object",
+            "additionalProperties": {"$ref": "#/components/schemas/SyncResult"}
+          }
+        }
+      }
+    }
+  }
+}`
+
+func openapiHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(openapiSpec))
+}
+
+// docsHandler serves a minimal Swagger UI page pointed at openapiHandler,
+// so the API is self-documenting without shipping a generated client.
+func docsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html")
+	w.Write([]byte(`<!DOCTYPE html>
+<html>
+<head>
+  <title>gsoc-org API docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => {
+      SwaggerUIBundle({
+        url: "/api/v1/openapi.json",
+        dom_id: "#swagger-ui",
+      });
+    };
+  </script>
+</body>
+</html>`))
+}
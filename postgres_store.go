@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/lib/pq"
+)
+
+var postgresMigrations = []migration{
+	{Version: 1, SQL: `
+		CREATE TABLE IF NOT EXISTS organizations (
+			id SERIAL PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			description TEXT,
+			url VARCHAR(255),
+			year INT NOT NULL,
+			UNIQUE (name, year)
+		)`},
+	{Version: 2, SQL: `
+		CREATE TABLE IF NOT EXISTS sync_state (
+			year INT PRIMARY KEY,
+			etag VARCHAR(255),
+			last_modified VARCHAR(255)
+		)`},
+}
+
+const postgresTrackingTableSQL = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INT PRIMARY KEY
+	)`
+
+// postgresStore is the lib/pq-backed Store implementation.
+type postgresStore struct {
+	db *sql.DB
+}
+
+func newPostgresStore(dsn string) (Store, error) {
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &postgresStore{db: conn}, nil
+}
+
+func (s *postgresStore) Init(ctx context.Context) error {
+	return runMigrations(ctx, s.db, postgresTrackingTableSQL,
+		"INSERT INTO schema_migrations (version) VALUES ($1)", postgresMigrations)
+}
+
+func (s *postgresStore) ListOrgs(ctx context.Context, filter OrgFilter) ([]Org, int, error) {
+	limit, offset := clampFilter(filter)
+	order, ok := sortColumns[filter.Sort]
+	if !ok {
+		order = "year DESC, name ASC"
+	}
+
+	var where []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Year != 0 {
+		where = append(where, "year = "+arg(filter.Year))
+	}
+	if filter.Name != "" {
+		where = append(where, "name ILIKE "+arg("%"+filter.Name+"%"))
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM organizations %s", whereClause)
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := fmt.Sprintf(
+		"SELECT id, name, description, url, year FROM organizations %s ORDER BY %s LIMIT %s OFFSET %s",
+		whereClause, order, arg(limit), arg(offset),
+	)
+	rows, err := s.db.QueryContext(ctx, listQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var orgs []Org
+	for rows.Next() {
+		var o Org
+		if err := rows.Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year); err != nil {
+			return nil, 0, err
+		}
+		orgs = append(orgs, o)
+	}
+	return orgs, total, rows.Err()
+}
+
+func (s *postgresStore) GetOrg(ctx context.Context, id int) (Org, error) {
+	var o Org
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, name, description, url, year FROM organizations WHERE id = $1", id,
+	).Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year)
+	return o, err
+}
+
+func (s *postgresStore) CreateOrg(ctx context.Context, o Org) (Org, error) {
+	err := s.db.QueryRowContext(ctx,
+		"INSERT INTO organizations (name, description, url, year) VALUES ($1, $2, $3, $4) RETURNING id",
+		o.Name, o.Description, o.URL, o.Year,
+	).Scan(&o.ID)
+	if err != nil {
+		return Org{}, err
+	}
+	return o, nil
+}
+
+func (s *postgresStore) UpdateOrg(ctx context.Context, id int, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		"UPDATE organizations SET name = $1, description = $2, url = $3, year = $4 WHERE id = $5",
+		o.Name, o.Description, o.URL, o.Year, id,
+	)
+	return err
+}
+
+func (s *postgresStore) DeleteOrg(ctx context.Context, id int) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM organizations WHERE id = $1", id)
+	return err
+}
+
+func (s *postgresStore) UpsertOrg(ctx context.Context, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO organizations (name, description, url, year) VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (name, year) DO UPDATE SET description = EXCLUDED.description, url = EXCLUDED.url`,
+		o.Name, o.Description, o.URL, o.Year,
+	)
+	return err
+}
+
+func (s *postgresStore) GetSyncState(ctx context.Context, year int) (etag, lastModified string, err error) {
+	err = s.db.QueryRowContext(ctx,
+		"SELECT etag, last_modified FROM sync_state WHERE year = $1", year,
+	).Scan(&etag, &lastModified)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	return etag, lastModified, err
+}
+
+func (s *postgresStore) SetSyncState(ctx context.Context, year int, etag, lastModified string) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO sync_state (year, etag, last_modified) VALUES ($1, $2, $3)
+		 ON CONFLICT (year) DO UPDATE SET etag = EXCLUDED.etag, last_modified = EXCLUDED.last_modified`,
+		year, etag, lastModified,
+	)
+	return err
+}
+
+func (s *postgresStore) Years(ctx context.Context) ([]int, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT DISTINCT year FROM organizations ORDER BY year DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var years []int
+	for rows.Next() {
+		var y int
+		if err := rows.Scan(&y); err != nil {
+			return nil, err
+		}
+		years = append(years, y)
+	}
+	return years, rows.Err()
+}
+
+func (s *postgresStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *postgresStore) Close() error {
+	return s.db.Close()
+}
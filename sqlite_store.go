@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "modernc.org/sqlite"
+)
+
+var sqliteMigrations = []migration{
+	{Version: 1, SQL: `
+		CREATE TABLE IF NOT EXISTS organizations (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			description TEXT,
+			url TEXT,
+			year INTEGER NOT NULL,
+			UNIQUE (name, year)
+		)`},
+	{Version: 2, SQL: `
+		CREATE TABLE IF NOT EXISTS sync_state (
+			year INTEGER PRIMARY KEY,
+			etag TEXT,
+			last_modified TEXT
+		)`},
+}
+
+const sqliteTrackingTableSQL = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY
+	)`
+
+// sqliteStore is a CGO-free Store implementation, used for local
+// development and in unit tests that need a real database without a
+// container.
+type sqliteStore struct {
+	db *sql.DB
+}
+
+func newSQLiteStore(dsn string) (Store, error) {
+	conn, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteStore{db: conn}, nil
+}
+
+func (s *sqliteStore) Init(ctx context.Context) error {
+	return runMigrations(ctx, s.db, sqliteTrackingTableSQL,
+		"INSERT INTO schema_migrations (version) VALUES (?)", sqliteMigrations)
+}
+
+func (s *sqliteStore) ListOrgs(ctx context.Context, filter OrgFilter) ([]Org, int, error) {
+	limit, offset := clampFilter(filter)
+	order, ok := sortColumns[filter.Sort]
+	if !ok {
+		order = "year DESC, name ASC"
+	}
+
+	var where []string
+	var args []interface{}
+	if filter.Year != 0 {
+		where = append(where, "year = ?")
+		args = append(args, filter.Year)
+	}
+	if filter.Name != "" {
+		where = append(where, "name LIKE ?")
+		args = append(args, "%"+filter.Name+"%")
+	}
+	whereClause := ""
+	if len(where) > 0 {
+		whereClause = "WHERE " + strings.Join(where, " AND ")
+	}
+
+	var total int
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM organizations %s", whereClause)
+	if err := s.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery := fmt.Sprintf(
+		"SELECT id, name, description, url, year FROM organizations %s ORDER BY %s LIMIT ? OFFSET ?",
+		whereClause, order,
+	)
+	rows, err := s.db.QueryContext(ctx, listQuery, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var orgs []Org
+	for rows.Next() {
+		var o Org
+		if err := rows.Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year); err != nil {
+			return nil, 0, err
+		}
+		orgs = append(orgs, o)
+	}
+	return orgs, total, rows.Err()
+}
+
+func (s *sqliteStore) GetOrg(ctx context.Context, id int) (Org, error) {
+	var o Org
+	err := s.db.QueryRowContext(ctx,
+		"SELECT id, name, description, url, year FROM organizations WHERE id = ?", id,
+	).Scan(&o.ID, &o.Name, &o.Description, &o.URL, &o.Year)
+	return o, err
+}
+
+func (s *sqliteStore) CreateOrg(ctx context.Context, o Org) (Org, error) {
+	res, err := s.db.ExecContext(ctx,
+		"INSERT INTO organizations (name, description, url, year) VALUES (?, ?, ?, ?)",
+		o.Name, o.Description, o.URL, o.Year,
+	)
+	if err != nil {
+		return Org{}, err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Org{}, err
+	}
+	o.ID = int(id)
+	return o, nil
+}
+
+func (s *sqliteStore) UpdateOrg(ctx context.Context, id int, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		"UPDATE organizations SET name = ?, description = ?, url = ?, year = ? WHERE id = ?",
+		o.Name, o.Description, o.URL, o.Year, id,
+	)
+	return err
+}
+
+func (s *sqliteStore) DeleteOrg(ctx context.Context, id int) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM organizations WHERE id = ?", id)
+	return err
+}
+
+func (s *sqliteStore) UpsertOrg(ctx context.Context, o Org) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO organizations (name, description, url, year) VALUES (?, ?, ?, ?)
+		 ON CONFLICT (name, year) DO UPDATE SET description = excluded.description, url = excluded.url`,
+		o.Name, o.Description, o.URL, o.Year,
+	)
+	return err
+}
+
+func (s *sqliteStore) GetSyncState(ctx context.Context, year int) (etag, lastModified string, err error) {
+	err = s.db.QueryRowContext(ctx,
+		"SELECT etag, last_modified FROM sync_state WHERE year = ?", year,
+	).Scan(&etag, &lastModified)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	return etag, lastModified, err
+}
+
+func (s *sqliteStore) SetSyncState(ctx context.Context, year int, etag, lastModified string) error {
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO sync_state (year, etag, last_modified) VALUES (?, ?, ?)
+		 ON CONFLICT (year) DO UPDATE SET etag = excluded.etag, last_modified = excluded.last_modified`,
+		year, etag, lastModified,
+	)
+	return err
+}
+
+func (s *sqliteStore) Years(ctx context.Context) ([]int, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT DISTINCT year FROM organizations ORDER BY year DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var years []int
+	for rows.Next() {
+		var y int
+		if err := rows.Scan(&y); err != nil {
+			return nil, err
+		}
+		years = append(years, y)
+	}
+	return years, rows.Err()
+}
+
+func (s *sqliteStore) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+func (s *sqliteStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// OrgFilter narrows down a ListOrgs call. Zero values mean "no filter" /
+// "use the default" for that field.
+type OrgFilter struct {
+	Year   int    // 0 means all years
+	Name   string // substring match, case-insensitive; "" means no filter
+	Limit  int    // page size, capped and defaulted by each Store
+	Offset int
+	Sort   string // "name", "-name", "year", "-year"; "" means the default order
+}
+
+const defaultLimit = 20
+const maxLimit = 100
+
+// Store is the persistence contract the rest of the app depends on. Each
+// implementation owns its DDL, its migrations, and its SQL dialect, so
+// swapping DB_DRIVER never touches handler code.
+type Store interface {
+	// Init applies migrations, creating the schema if needed.
+	Init(ctx context.Context) error
+	ListOrgs(ctx context.Context, filter OrgFilter) ([]Org, int, error)
+	GetOrg(ctx context.Context, id int) (Org, error)
+	CreateOrg(ctx context.Context, o Org) (Org, error)
+	UpdateOrg(ctx context.Context, id int, o Org) error
+	DeleteOrg(ctx context.Context, id int) error
+	// UpsertOrg inserts o, or updates the existing (name, year) row's
+	// description and url if it already exists.
+	UpsertOrg(ctx context.Context, o Org) error
+	Years(ctx context.Context) ([]int, error)
+
+	// GetSyncState returns the ETag/Last-Modified recorded for year's
+	// last successful sync, or two empty strings if none is recorded.
+	GetSyncState(ctx context.Context, year int) (etag, lastModified string, err error)
+	SetSyncState(ctx context.Context, year int, etag, lastModified string) error
+
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// migration is one versioned, idempotent schema change for a single driver.
+type migration struct {
+	Version int
+	SQL     string
+}
+
+// clampFilter fills in the paging defaults shared by every driver.
+func clampFilter(filter OrgFilter) (limit, offset int) {
+	limit = filter.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	offset = filter.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// runMigrations applies each not-yet-applied migration in order, tracked
+// in a schema_migrations table created by createTrackingTableSQL (whose
+// syntax is driver-specific, so it's supplied by the caller).
+func runMigrations(ctx context.Context, conn *sql.DB, createTrackingTableSQL, insertVersionSQL string, migrations []migration) error {
+	if _, err := conn.ExecContext(ctx, createTrackingTableSQL); err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	applied := map[int]bool{}
+	rows, err := conn.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return fmt.Errorf("read schema_migrations: %w", err)
+	}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[v] = true
+	}
+	rows.Close()
+
+	for _, m := range migrations {
+		if applied[m.Version] {
+			continue
+		}
+		if _, err := conn.ExecContext(ctx, m.SQL); err != nil {
+			return fmt.Errorf("migration %d: %w", m.Version, err)
+		}
+		if _, err := conn.ExecContext(ctx, insertVersionSQL, m.Version); err != nil {
+			return fmt.Errorf("record migration %d: %w", m.Version, err)
+		}
+	}
+	return nil
+}
+
+// NewStore builds the Store selected by driver ("mysql", "postgres", or
+// "sqlite"), wiring it to dsn but not yet connecting or migrating it.
+func NewStore(driver, dsn string) (Store, error) {
+	switch driver {
+	case "", "mysql":
+		return newMySQLStore(dsn)
+	case "postgres":
+		return newPostgresStore(dsn)
+	case "sqlite":
+		return newSQLiteStore(dsn)
+	default:
+		return nil, fmt.Errorf("unknown DB_DRIVER %q", driver)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func newTestStore(t *testing.T) Store {
+	t.Helper()
+	store, err := newSQLiteStore(":memory:")
+	if err != nil {
+		t.Fatalf("newSQLiteStore: %v", err)
+	}
+	if err := store.Init(context.Background()); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestSQLiteStoreCRUD(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	created, err := store.CreateOrg(ctx, Org{Name: "Test Org", Description: "desc", URL: "https://example.com", Year: 2025})
+	if err != nil {
+		t.Fatalf("CreateOrg: %v", err)
+	}
+	if created.ID == 0 {
+		t.Fatalf("expected a non-zero id, got %d", created.ID)
+	}
+
+	got, err := store.GetOrg(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetOrg: %v", err)
+	}
+	if got.Name != "Test Org" {
+		t.Errorf("Name = %q, want %q", got.Name, "Test Org")
+	}
+
+	got.Description = "updated"
+	if err := store.UpdateOrg(ctx, created.ID, got); err != nil {
+		t.Fatalf("UpdateOrg: %v", err)
+	}
+	updated, err := store.GetOrg(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetOrg after update: %v", err)
+	}
+	if updated.Description != "updated" {
+		t.Errorf("Description = %q, want %q", updated.Description, "updated")
+	}
+
+	if err := store.DeleteOrg(ctx, created.ID); err != nil {
+		t.Fatalf("DeleteOrg: %v", err)
+	}
+	if _, err := store.GetOrg(ctx, created.ID); err == nil {
+		t.Error("expected an error fetching a deleted org, got nil")
+	}
+}
+
+func TestSQLiteStoreListOrgsFilters(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	orgs := []Org{
+		{Name: "Alpha", Year: 2024},
+		{Name: "Beta", Year: 2025},
+		{Name: "Gamma", Year: 2025},
+	}
+	for _, o := range orgs {
+		if _, err := store.CreateOrg(ctx, o); err != nil {
+			t.Fatalf("CreateOrg(%q): %v", o.Name, err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		filter    OrgFilter
+		wantTotal int
+	}{
+		{name: "no filter", filter: OrgFilter{}, wantTotal: 3},
+		{name: "by year", filter: OrgFilter{Year: 2025}, wantTotal: 2},
+		{name: "by name substring", filter: OrgFilter{Name: "am"}, wantTotal: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, total, err := store.ListOrgs(ctx, tt.filter)
+			if err != nil {
+				t.Fatalf("ListOrgs: %v", err)
+			}
+			if total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", total, tt.wantTotal)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSyncInterval = 6 * time.Hour
+	defaultSyncWorkers  = 4
+
+	backoffBase = 1 * time.Second
+	backoffCap  = 60 * time.Second
+	maxAttempts = 5
+)
+
+var syncYears = []int{2022, 2023, 2024, 2025}
+
+// SyncResult is the outcome of syncing a single year, surfaced on the
+// Syncer's Results channel and folded into its Status.
+type SyncResult struct {
+	Year     int           `json:"year"`
+	Status   string        `json:"status"` // "synced", "not_modified", "error"
+	RowCount int           `json:"rowCount"`
+	Duration time.Duration `json:"durationNs"`
+	Err      error         `json:"-"`
+	ErrMsg   string        `json:"error,omitempty"`
+}
+
+// SyncStatus is a snapshot of the Syncer's most recent run.
+type SyncStatus struct {
+	LastRun time.Time          `json:"lastRun"`
+	Years   map[int]SyncResult `json:"years"`
+}
+
+// Syncer periodically refreshes the organizations table from the upstream
+// API, replacing the old inline /sync handler so a request never blocks
+// on four sequential HTTP fetches.
+type Syncer struct {
+	store    Store
+	interval time.Duration
+	workers  int
+
+	trigger chan struct{}
+	results chan SyncResult
+
+	mu     sync.Mutex
+	status SyncStatus
+}
+
+// NewSyncer reads SYNC_INTERVAL (default 6h) and SYNC_WORKERS (default 4)
+// from the environment to configure the returned Syncer.
+func NewSyncer(store Store) *Syncer {
+	interval := defaultSyncInterval
+	if v := os.Getenv("SYNC_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			interval = d
+		}
+	}
+	workers := defaultSyncWorkers
+	if v := os.Getenv("SYNC_WORKERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			workers = n
+		}
+	}
+
+	return &Syncer{
+		store:    store,
+		interval: interval,
+		workers:  workers,
+		trigger:  make(chan struct{}, 1),
+		results:  make(chan SyncResult, len(syncYears)),
+		status:   SyncStatus{Years: map[int]SyncResult{}},
+	}
+}
+
+// Results returns the channel that per-year outcomes are published on.
+func (sy *Syncer) Results() <-chan SyncResult {
+	return sy.results
+}
+
+// Status returns a snapshot of the most recent run.
+func (sy *Syncer) Status() SyncStatus {
+	sy.mu.Lock()
+	defer sy.mu.Unlock()
+
+	years := make(map[int]SyncResult, len(sy.status.Years))
+	for y, r := range sy.status.Years {
+		years[y] = r
+	}
+	return SyncStatus{LastRun: sy.status.LastRun, Years: years}
+}
+
+// Trigger enqueues an immediate run without blocking the caller. If a run
+// is already pending, the request is coalesced into it.
+func (sy *Syncer) Trigger() {
+	select {
+	case sy.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run starts the sync loop: an initial run, then one every interval, plus
+// any runs requested via Trigger. It blocks until ctx is done.
+func (sy *Syncer) Run(ctx context.Context) {
+	sy.runOnce(ctx)
+
+	ticker := time.NewTicker(sy.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sy.runOnce(ctx)
+		case <-sy.trigger:
+			sy.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce fans out syncYear across a bounded worker pool and waits for
+// every year to finish before marking the run's end time.
+func (sy *Syncer) runOnce(ctx context.Context) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for i := 0; i < sy.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for year := range jobs {
+				sy.recordResult(sy.syncYear(ctx, year))
+			}
+		}()
+	}
+
+	for _, year := range syncYears {
+		jobs <- year
+	}
+	close(jobs)
+	wg.Wait()
+
+	sy.mu.Lock()
+	sy.status.LastRun = time.Now()
+	sy.mu.Unlock()
+
+	state.initialSyncDone.Store(true)
+}
+
+func (sy *Syncer) recordResult(res SyncResult) {
+	sy.mu.Lock()
+	sy.status.Years[res.Year] = res
+	sy.mu.Unlock()
+
+	year := strconv.Itoa(res.Year)
+	gsocSyncDuration.WithLabelValues(year).Observe(res.Duration.Seconds())
+	switch res.Status {
+	case "synced":
+		gsocOrgsTotal.WithLabelValues(year).Set(float64(res.RowCount))
+	case "error":
+		gsocSyncFailuresTotal.WithLabelValues(year, failureReason(res.Err)).Inc()
+	}
+
+	select {
+	case sy.results <- res:
+	default: // no one's listening; the status snapshot already has it
+	}
+}
+
+// syncYear fetches one year with conditional headers and retry/backoff,
+// then upserts whatever it got into the store.
+func (sy *Syncer) syncYear(ctx context.Context, year int) SyncResult {
+	start := time.Now()
+
+	etag, lastModified, err := sy.store.GetSyncState(ctx, year)
+	if err != nil {
+		return errResult(year, start, fmt.Errorf("reading sync state: %w", err))
+	}
+
+	url := fmt.Sprintf("%s%d.json", APIBaseURL, year)
+
+	var resp *http.Response
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if reqErr != nil {
+			return errResult(year, start, reqErr)
+		}
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if lastModified != "" {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+
+		resp, err = http.DefaultClient.Do(req)
+		if err != nil {
+			if attempt == maxAttempts-1 {
+				return errResult(year, start, err)
+			}
+			sleepBackoff(attempt)
+			continue
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			resp.Body.Close()
+			if attempt == maxAttempts-1 {
+				return errResult(year, start, fmt.Errorf("API returned %d after %d attempts", resp.StatusCode, maxAttempts))
+			}
+			sleepBackoff(attempt)
+			continue
+		}
+		break
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return SyncResult{Year: year, Status: "not_modified", Duration: time.Since(start)}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errResult(year, start, fmt.Errorf("API returned %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errResult(year, start, err)
+	}
+
+	var apiResponse map[string]interface{}
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return errResult(year, start, err)
+	}
+
+	rowCount := 0
+	for orgName, orgData := range apiResponse {
+		org := Org{Name: orgName, Description: "GSoC Organization", Year: year}
+		if dataMap, ok := orgData.(map[string]interface{}); ok {
+			if pURL, exists := dataMap["projects_url"].(string); exists {
+				org.URL = pURL
+			}
+		}
+		if err := sy.store.UpsertOrg(ctx, org); err != nil {
+			log.Printf("Upsert error for %s (%d): %v", orgName, year, err)
+			continue
+		}
+		rowCount++
+	}
+
+	if err := sy.store.SetSyncState(ctx, year, resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")); err != nil {
+		log.Printf("Failed to persist sync state for %d: %v", year, err)
+	}
+
+	return SyncResult{Year: year, Status: "synced", RowCount: rowCount, Duration: time.Since(start)}
+}
+
+func errResult(year int, start time.Time, err error) SyncResult {
+	return SyncResult{Year: year, Status: "error", Err: err, ErrMsg: err.Error(), Duration: time.Since(start)}
+}
+
+// failureReason maps an error to a small, fixed set of reason codes so the
+// gsoc_sync_failures_total label stays low-cardinality; the raw error text
+// (which can embed status codes, dial errors, or JSON detail) goes to the
+// log instead, via ErrMsg.
+func failureReason(err error) string {
+	if err == nil {
+		return "unknown"
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "timeout"
+	}
+
+	var syntaxErr *json.SyntaxError
+	var unmarshalErr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxErr) || errors.As(err, &unmarshalErr) {
+		return "parse_error"
+	}
+
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "reading sync state"), strings.Contains(msg, "database"):
+		return "db_error"
+	case strings.Contains(msg, "returned 429"):
+		return "429"
+	case strings.Contains(msg, "returned 5"):
+		return "5xx"
+	case strings.Contains(msg, "timeout"), strings.Contains(msg, "deadline exceeded"):
+		return "timeout"
+	default:
+		return "other"
+	}
+}
+
+// sleepBackoff waits base*2^attempt, capped at backoffCap, plus up to 50%
+// jitter, so a burst of years hitting 429s don't all retry in lockstep.
+func sleepBackoff(attempt int) {
+	d := backoffBase * time.Duration(1<<uint(attempt))
+	if d > backoffCap {
+		d = backoffCap
+	}
+	jitter := time.Duration(rand.Int63n(int64(d)/2 + 1))
+	time.Sleep(d/2 + jitter)
+}
+
+// syncStatusHandler serves GET /api/v1/sync/status.
+func (s *server) syncStatusHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.syncer.Status())
+}